@@ -1,13 +1,21 @@
 package main
 
 import (
+	"bufio"
 	"bytes"
+	"context"
 	"crypto/md5"
+	"database/sql"
 	"encoding/csv"
 	"encoding/json"
 	"fmt"
+	"image"
+	_ "image/jpeg"
+	_ "image/png"
 	"io"
 	"log"
+	"math"
+	"math/bits"
 	"math/rand"
 	"net/http"
 	"os"
@@ -21,9 +29,28 @@ import (
 	"github.com/PuerkitoBio/goquery"
 	"github.com/gofiber/fiber/v2"
 	"github.com/gofiber/fiber/v2/middleware/cors"
+	"github.com/gofiber/websocket/v2"
 	"golang.org/x/net/proxy"
+	"golang.org/x/time/rate"
+	_ "modernc.org/sqlite"
 )
 
+// Platform describes one site to probe. Method selects how scanPlatform
+// decides availability:
+//
+//   - status_code:  Code contains a "not found" HTTP status
+//   - response_text: ErrorMsg contains a substring of a "not found" page
+//   - regex:        ErrorMsg[0] is a regexp matched against the body
+//   - json_path:    fetch ApiEndpoint, assert JSONPath exists/equals JSONValue
+//   - header:       the HeaderName response header contains HeaderContains
+//   - title:        ErrorMsg matched (case-insensitively) against <title>
+//
+// Every method other than json_path/header shares the response_text
+// convention: the configured condition becoming true means the profile was
+// NOT found (i.e. the username is available). json_path and header instead
+// describe a "found" condition directly, since that's how WhatsMyName-style
+// API/redirect checks are naturally expressed; scanPlatform inverts it so
+// ScanResult.Available keeps the same meaning everywhere.
 type Platform struct {
 	URL          string   `json:"url"`
 	Method       string   `json:"method"`
@@ -31,6 +58,20 @@ type Platform struct {
 	ErrorMsg     []string `json:"error_msg,omitempty"`
 	ReconEnabled bool     `json:"recon_enabled,omitempty"`
 	ApiEndpoint  string   `json:"api_endpoint,omitempty"`
+
+	JSONPath       string `json:"json_path,omitempty"`
+	JSONValue      string `json:"json_value,omitempty"`
+	HeaderName     string `json:"header_name,omitempty"`
+	HeaderContains string `json:"header_contains,omitempty"`
+
+	RequestHeaders map[string]string `json:"request_headers,omitempty"`
+	Cookies        map[string]string `json:"cookies,omitempty"`
+	PostBody       string            `json:"post_body,omitempty"`
+
+	// RateRPS/RateBurst configure the per-host token bucket used to throttle
+	// requests to this platform. Zero means "use the defaults".
+	RateRPS   float64 `json:"rate_rps,omitempty"`
+	RateBurst int     `json:"rate_burst,omitempty"`
 }
 
 type ReconContact struct {
@@ -248,6 +289,100 @@ func getClient(proxyAddr string, tor bool) *http.Client {
 	return &http.Client{Timeout: 15 * time.Second}
 }
 
+// --- Rate limiting, request budgets and adaptive backoff per host ---
+//
+// A fixed 3-attempt retry firing ~30 concurrent requests is enough to get a
+// single IP banned by most platforms, so every platform request that
+// scanPlatform sends passes through two guards: a global concurrency
+// semaphore (independent of the per-scan worker pool) and a per-host token
+// bucket. Profile-image downloads and Telegram API calls do not go through
+// either guard.
+
+const (
+	defaultRateRPS       = 1.0
+	defaultRateBurst     = 3
+	defaultGlobalLimit   = 30
+	globalConcurrencyEnv = "GLOBAL_CONCURRENCY"
+)
+
+var globalRequestSemaphore = newGlobalSemaphore()
+
+func newGlobalSemaphore() chan struct{} {
+	limit := defaultGlobalLimit
+	if v := os.Getenv(globalConcurrencyEnv); v != "" {
+		if n, err := strconv.Atoi(v); err == nil && n > 0 {
+			limit = n
+		}
+	}
+	return make(chan struct{}, limit)
+}
+
+var hostLimiters = struct {
+	sync.Mutex
+	ByHost map[string]*rate.Limiter
+}{ByHost: make(map[string]*rate.Limiter)}
+
+// getHostLimiter returns (creating if necessary) the token bucket for host,
+// sized from the platform's RateRPS/RateBurst or the package defaults.
+func getHostLimiter(host string, platform Platform) *rate.Limiter {
+	hostLimiters.Lock()
+	defer hostLimiters.Unlock()
+	if l, ok := hostLimiters.ByHost[host]; ok {
+		return l
+	}
+	rps := platform.RateRPS
+	if rps <= 0 {
+		rps = defaultRateRPS
+	}
+	burst := platform.RateBurst
+	if burst <= 0 {
+		burst = defaultRateBurst
+	}
+	l := rate.NewLimiter(rate.Limit(rps), burst)
+	hostLimiters.ByHost[host] = l
+	return l
+}
+
+var platformRegexes = struct {
+	sync.Mutex
+	ByPattern map[string]*regexp.Regexp
+}{ByPattern: make(map[string]*regexp.Regexp)}
+
+// compilePlatformRegex returns (compiling and caching if necessary) the
+// regexp for pattern, so a "regex" method platform is compiled once rather
+// than on every scanPlatform call.
+func compilePlatformRegex(pattern string) (*regexp.Regexp, error) {
+	platformRegexes.Lock()
+	defer platformRegexes.Unlock()
+	if re, ok := platformRegexes.ByPattern[pattern]; ok {
+		return re, nil
+	}
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		return nil, err
+	}
+	platformRegexes.ByPattern[pattern] = re
+	return re, nil
+}
+
+// retryAfterDuration honours a 429/503 response's Retry-After header
+// (seconds or an HTTP date), falling back to a backoff that grows by two
+// seconds per attempt (2s, 4s, 6s) when the header is absent, unparsable or
+// names a time already past.
+func retryAfterDuration(header string, attempt int) time.Duration {
+	if header != "" {
+		if secs, err := strconv.Atoi(header); err == nil {
+			return time.Duration(secs) * time.Second
+		}
+		if t, err := http.ParseTime(header); err == nil {
+			if d := time.Until(t); d > 0 {
+				return d
+			}
+		}
+	}
+	return time.Duration(attempt+1) * 2 * time.Second
+}
+
 // Recon logic
 func extractContactInfo(doc *goquery.Document, url string) ReconContact {
 	text := doc.Text()
@@ -326,6 +461,12 @@ func extractProfileImage(doc *goquery.Document, url string) ReconImage {
 		defer resp.Body.Close()
 		data, err := io.ReadAll(resp.Body)
 		if err == nil {
+			if hash, err := computePHash(data); err == nil {
+				return ReconImage{URL: imgURL, Hash: fmt.Sprintf("%016x", hash), Downloaded: true}
+			}
+			// Fall back to an MD5 content hash if the image couldn't be
+			// decoded (e.g. unsupported format). correlateProfileImages
+			// ignores these hashes; only pHashes are compared there.
 			hash := fmt.Sprintf("%x", md5.Sum(data))
 			return ReconImage{URL: imgURL, Hash: hash, Downloaded: true}
 		}
@@ -333,6 +474,174 @@ func extractProfileImage(doc *goquery.Document, url string) ReconImage {
 	return ReconImage{URL: imgURL}
 }
 
+// --- Perceptual hashing (pHash) for cross-platform profile-picture correlation ---
+//
+// computePHash decodes the image, downsamples it to 32x32 grayscale, runs a
+// 2D DCT, and keeps the top-left 8x8 block of coefficients (the low
+// frequencies, which survive recompression). Each bit of the resulting
+// 64-bit hash is set when that coefficient is above the median of the block
+// excluding the DC term, so two images of the same picture hash close
+// together even after JPEG re-encoding or resizing.
+func computePHash(data []byte) (uint64, error) {
+	img, _, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return 0, err
+	}
+	pixels := grayscale32x32(img)
+
+	dct := make([][]float64, 32)
+	for y := range pixels {
+		dct[y] = dct1D(pixels[y])
+	}
+	col := make([]float64, 32)
+	for x := 0; x < 32; x++ {
+		for y := 0; y < 32; y++ {
+			col[y] = dct[y][x]
+		}
+		transformed := dct1D(col)
+		for y := 0; y < 32; y++ {
+			dct[y][x] = transformed[y]
+		}
+	}
+
+	var coeffs [64]float64
+	idx := 0
+	for y := 0; y < 8; y++ {
+		for x := 0; x < 8; x++ {
+			coeffs[idx] = dct[y][x]
+			idx++
+		}
+	}
+	ac := append([]float64{}, coeffs[1:]...) // exclude the DC term (index 0)
+	sorted := append([]float64{}, ac...)
+	sort.Float64s(sorted)
+	median := sorted[len(sorted)/2]
+
+	var hash uint64
+	for i, c := range coeffs {
+		if i != 0 && c > median {
+			hash |= 1 << uint(i)
+		}
+	}
+	return hash, nil
+}
+
+// grayscale32x32 downsamples img to a 32x32 luma grid via nearest-neighbor
+// sampling, which is sufficient precision for a perceptual hash.
+func grayscale32x32(img image.Image) [][]float64 {
+	bounds := img.Bounds()
+	w, h := bounds.Dx(), bounds.Dy()
+	if w == 0 {
+		w = 1
+	}
+	if h == 0 {
+		h = 1
+	}
+	out := make([][]float64, 32)
+	for y := 0; y < 32; y++ {
+		out[y] = make([]float64, 32)
+		srcY := bounds.Min.Y + y*h/32
+		for x := 0; x < 32; x++ {
+			srcX := bounds.Min.X + x*w/32
+			r, g, b, _ := img.At(srcX, srcY).RGBA()
+			out[y][x] = 0.299*float64(r>>8) + 0.587*float64(g>>8) + 0.114*float64(b>>8)
+		}
+	}
+	return out
+}
+
+// dct1D computes the 1-dimensional DCT-II of input.
+func dct1D(input []float64) []float64 {
+	n := len(input)
+	output := make([]float64, n)
+	for k := 0; k < n; k++ {
+		var sum float64
+		for i := 0; i < n; i++ {
+			sum += input[i] * math.Cos(math.Pi/float64(n)*(float64(i)+0.5)*float64(k))
+		}
+		output[k] = sum
+	}
+	return output
+}
+
+// hammingDistance returns the number of differing bits between two pHashes.
+func hammingDistance(a, b uint64) int {
+	return bits.OnesCount64(a ^ b)
+}
+
+// imageCorrelationThreshold is the maximum Hamming distance between two
+// pHashes for their profile images to be considered the same picture.
+const imageCorrelationThreshold = 10
+
+// ImageCluster groups ScanResults whose profile images are within
+// imageCorrelationThreshold bits of each other, suggesting the same person.
+type ImageCluster struct {
+	Hash      string   `json:"hash"`
+	Platforms []string `json:"platforms"`
+}
+
+// correlateProfileImages unions ScanResults with a recon'd profile image
+// whose pHashes are within imageCorrelationThreshold bits of each other.
+func correlateProfileImages(results []ScanResult) []ImageCluster {
+	type hashed struct {
+		platform string
+		hash     uint64
+	}
+	var candidates []hashed
+	for _, r := range results {
+		h := r.ReconData.ProfileImage.Hash
+		if len(h) != 16 { // not a 64-bit hex pHash (missing or MD5 fallback)
+			continue
+		}
+		if v, err := strconv.ParseUint(h, 16, 64); err == nil {
+			candidates = append(candidates, hashed{platform: r.Platform, hash: v})
+		}
+	}
+
+	parent := make([]int, len(candidates))
+	for i := range parent {
+		parent[i] = i
+	}
+	var find func(int) int
+	find = func(i int) int {
+		if parent[i] != i {
+			parent[i] = find(parent[i])
+		}
+		return parent[i]
+	}
+	for i := 0; i < len(candidates); i++ {
+		for j := i + 1; j < len(candidates); j++ {
+			if hammingDistance(candidates[i].hash, candidates[j].hash) <= imageCorrelationThreshold {
+				parent[find(i)] = find(j)
+			}
+		}
+	}
+
+	groups := make(map[int][]hashed)
+	for i, c := range candidates {
+		root := find(i)
+		groups[root] = append(groups[root], c)
+	}
+
+	var clusters []ImageCluster
+	for _, members := range groups {
+		if len(members) < 2 {
+			continue // a "cluster" of one platform correlates with nothing
+		}
+		platformNames := make([]string, 0, len(members))
+		for _, m := range members {
+			platformNames = append(platformNames, m.platform)
+		}
+		sort.Strings(platformNames)
+		clusters = append(clusters, ImageCluster{
+			Hash:      fmt.Sprintf("%016x", members[0].hash),
+			Platforms: platformNames,
+		})
+	}
+	sort.Slice(clusters, func(i, j int) bool { return clusters[i].Hash < clusters[j].Hash })
+	return clusters
+}
+
 // Google dorks
 func generateGoogleDorks(username string) []string {
 	return []string{
@@ -352,29 +661,74 @@ func generateGoogleDorks(username string) []string {
 // Scan logic with retries
 func scanPlatform(username string, platformName string, platform Platform, client *http.Client, deepScan bool) ScanResult {
 	url := fmt.Sprintf(platform.URL, username)
+	// json_path checks a platform's API rather than its profile page, so the
+	// fetch target differs from the URL we report back in the result.
+	fetchURL := url
+	if platform.Method == "json_path" && platform.ApiEndpoint != "" {
+		fetchURL = fmt.Sprintf(platform.ApiEndpoint, username)
+	}
 	result := ScanResult{
 		Platform: platformName,
 		URL:      url,
 	}
 	// Add this log for debugging
 	if logger != nil {
-		logger.Printf("Scanning %s: %s", platformName, url)
+		logger.Printf("Scanning %s: %s", platformName, fetchURL)
 	}
 	var resp *http.Response
 	var err error
 	for attempt := 0; attempt < 3; attempt++ {
-		req, reqErr := http.NewRequest("GET", url, nil)
+		method := "GET"
+		var body io.Reader
+		if platform.PostBody != "" {
+			method = "POST"
+			body = strings.NewReader(platform.PostBody)
+		}
+		req, reqErr := http.NewRequest(method, fetchURL, body)
 		if reqErr != nil {
 			err = reqErr
 			time.Sleep(time.Second * time.Duration(attempt+1))
 			continue
 		}
 		req.Header.Set("User-Agent", getRandomUserAgent())
-		resp, err = client.Do(req)
-		if err == nil {
-			break
+		for name, value := range platform.RequestHeaders {
+			req.Header.Set(name, value)
+		}
+		for name, value := range platform.Cookies {
+			req.AddCookie(&http.Cookie{Name: name, Value: value})
+		}
+
+		if waitErr := getHostLimiter(req.URL.Host, platform).Wait(context.Background()); waitErr != nil {
+			err = waitErr
+			time.Sleep(time.Second * time.Duration(attempt+1))
+			continue
+		}
+
+		// The header method inspects the first response itself (e.g. a
+		// redirect's Location), so redirects are not followed for it.
+		reqClient := client
+		if platform.Method == "header" {
+			noRedirect := *client
+			noRedirect.CheckRedirect = func(*http.Request, []*http.Request) error { return http.ErrUseLastResponse }
+			reqClient = &noRedirect
+		}
+
+		globalRequestSemaphore <- struct{}{}
+		resp, err = reqClient.Do(req)
+		<-globalRequestSemaphore
+
+		if err != nil {
+			time.Sleep(time.Second * time.Duration(attempt+1))
+			continue
 		}
-		time.Sleep(time.Second * time.Duration(attempt+1))
+		if resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode == http.StatusServiceUnavailable {
+			wait := retryAfterDuration(resp.Header.Get("Retry-After"), attempt)
+			resp.Body.Close()
+			err = fmt.Errorf("rate limited by %s (status %d)", req.URL.Host, resp.StatusCode)
+			time.Sleep(wait)
+			continue
+		}
+		break
 	}
 	if err != nil {
 		result.Available = nil
@@ -383,7 +737,8 @@ func scanPlatform(username string, platformName string, platform Platform, clien
 	}
 	defer resp.Body.Close()
 	var available bool
-	if platform.Method == "status_code" {
+	switch platform.Method {
+	case "status_code":
 		for _, code := range platform.Code {
 			if resp.StatusCode == code {
 				available = true
@@ -391,7 +746,7 @@ func scanPlatform(username string, platformName string, platform Platform, clien
 			}
 		}
 		result.Available = &available
-	} else if platform.Method == "response_text" {
+	case "response_text":
 		bodyBytes, _ := io.ReadAll(resp.Body)
 		bodyStr := strings.ToLower(string(bodyBytes))
 		for _, msg := range platform.ErrorMsg {
@@ -402,7 +757,52 @@ func scanPlatform(username string, platformName string, platform Platform, clien
 		}
 		result.Available = &available
 		resp.Body = io.NopCloser(bytes.NewReader(bodyBytes))
-	} else {
+	case "regex":
+		bodyBytes, _ := io.ReadAll(resp.Body)
+		if len(platform.ErrorMsg) > 0 {
+			re, reErr := compilePlatformRegex(platform.ErrorMsg[0])
+			if reErr != nil {
+				result.Error = fmt.Sprintf("invalid regex: %v", reErr)
+				resp.Body = io.NopCloser(bytes.NewReader(bodyBytes))
+				return result
+			}
+			available = re.Match(bodyBytes)
+		}
+		result.Available = &available
+		resp.Body = io.NopCloser(bytes.NewReader(bodyBytes))
+	case "json_path":
+		bodyBytes, _ := io.ReadAll(resp.Body)
+		found := false
+		var parsed map[string]interface{}
+		if jsonErr := json.Unmarshal(bodyBytes, &parsed); jsonErr == nil {
+			value, exists := lookupJSONPath(parsed, platform.JSONPath)
+			if platform.JSONValue == "" {
+				found = exists
+			} else {
+				found = exists && fmt.Sprintf("%v", value) == platform.JSONValue
+			}
+		}
+		available = !found // a matched field means the account was found
+		result.Available = &available
+		resp.Body = io.NopCloser(bytes.NewReader(bodyBytes))
+	case "header":
+		found := strings.Contains(resp.Header.Get(platform.HeaderName), platform.HeaderContains)
+		available = !found // a matched header (e.g. a login redirect) means the account was found
+		result.Available = &available
+	case "title":
+		bodyBytes, _ := io.ReadAll(resp.Body)
+		if doc, docErr := goquery.NewDocumentFromReader(bytes.NewReader(bodyBytes)); docErr == nil {
+			title := strings.ToLower(doc.Find("title").Text())
+			for _, msg := range platform.ErrorMsg {
+				if strings.Contains(title, strings.ToLower(msg)) {
+					available = true
+					break
+				}
+			}
+		}
+		result.Available = &available
+		resp.Body = io.NopCloser(bytes.NewReader(bodyBytes))
+	default:
 		result.Available = nil
 	}
 	// Recon if taken
@@ -468,6 +868,28 @@ func loadPlatforms(configPath string) map[string]Platform {
 	return platforms
 }
 
+// lookupJSONPath walks a dot-separated path (e.g. "data.user.login") through
+// a decoded JSON object and reports whether the final key exists, along with
+// its value.
+func lookupJSONPath(data map[string]interface{}, path string) (interface{}, bool) {
+	if path == "" {
+		return nil, false
+	}
+	current := interface{}(data)
+	for _, key := range strings.Split(path, ".") {
+		m, ok := current.(map[string]interface{})
+		if !ok {
+			return nil, false
+		}
+		value, exists := m[key]
+		if !exists {
+			return nil, false
+		}
+		current = value
+	}
+	return current, true
+}
+
 // --- 11. Logging to file ---
 var logger *log.Logger
 
@@ -489,7 +911,7 @@ func saveResultsToFile(results []ScanResult, username, format string) error {
 		}
 		defer f.Close()
 		w := csv.NewWriter(f)
-		w.Write([]string{"Platform", "Status", "URL", "Emails", "Phones", "URLs", "Location", "Bio"})
+		w.Write([]string{"Platform", "Status", "URL", "Emails", "Phones", "URLs", "Location", "Bio", "ImageHash"})
 		for _, r := range results {
 			status := "ERROR"
 			if r.Available != nil && *r.Available {
@@ -503,7 +925,7 @@ func saveResultsToFile(results []ScanResult, username, format string) error {
 			urls := strings.Join(r.ReconData.ContactInfo.URLs, "; ")
 			location := r.ReconData.ContactInfo.Location
 			bio := r.ReconData.ContactInfo.Bio
-			w.Write([]string{r.Platform, status, r.URL, emails, phones, urls, location, bio})
+			w.Write([]string{r.Platform, status, r.URL, emails, phones, urls, location, bio, r.ReconData.ProfileImage.Hash})
 		}
 		w.Flush()
 		return nil
@@ -553,12 +975,347 @@ func retryFailedPlatforms(results []ScanResult, username string, proxy string, t
 	return results
 }
 
+// --- Persistent scan history (SQLite) ---
+//
+// Scans used to be ephemeral: results only existed in the HTTP response or a
+// timestamped export file. db stores every ScanResult keyed by
+// (username, platform, scanned_at) so past scans can be listed, diffed, and
+// monitored for changes over time.
+var db *sql.DB
+
+const scanHistorySchema = `
+CREATE TABLE IF NOT EXISTS scan_history (
+	id INTEGER PRIMARY KEY AUTOINCREMENT,
+	username TEXT NOT NULL,
+	platform TEXT NOT NULL,
+	scanned_at TEXT NOT NULL,
+	available INTEGER,
+	error TEXT,
+	bio TEXT,
+	location TEXT,
+	image_hash TEXT,
+	result_json TEXT NOT NULL
+);
+CREATE INDEX IF NOT EXISTS idx_scan_history_username ON scan_history(username, scanned_at);
+
+CREATE TABLE IF NOT EXISTS monitors (
+	username TEXT PRIMARY KEY,
+	interval_seconds INTEGER NOT NULL,
+	last_checked_at TEXT
+);
+`
+
+func initDB(path string) (*sql.DB, error) {
+	database, err := sql.Open("sqlite", path)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := database.Exec(scanHistorySchema); err != nil {
+		database.Close()
+		return nil, err
+	}
+	return database, nil
+}
+
+// HistoryEntry is one persisted (username, platform, scanned_at) row.
+type HistoryEntry struct {
+	Platform  string `json:"platform"`
+	ScannedAt string `json:"scanned_at"`
+	Available *bool  `json:"available"`
+	Error     string `json:"error,omitempty"`
+	Bio       string `json:"bio,omitempty"`
+	Location  string `json:"location,omitempty"`
+	ImageHash string `json:"image_hash,omitempty"`
+}
+
+func persistScanResults(username string, results []ScanResult, scannedAt time.Time) {
+	if db == nil {
+		return
+	}
+	timestamp := scannedAt.UTC().Format(time.RFC3339)
+	for _, r := range results {
+		raw, _ := json.Marshal(r)
+		var available interface{}
+		if r.Available != nil {
+			available = *r.Available
+		}
+		_, err := db.Exec(
+			`INSERT INTO scan_history (username, platform, scanned_at, available, error, bio, location, image_hash, result_json) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+			username, r.Platform, timestamp, available, r.Error,
+			r.ReconData.ContactInfo.Bio, r.ReconData.ContactInfo.Location, r.ReconData.ProfileImage.Hash, string(raw),
+		)
+		if err != nil && logger != nil {
+			logger.Printf("persistScanResults: insert failed for %s/%s: %v", username, r.Platform, err)
+		}
+	}
+}
+
+// fetchScanSnapshot loads every platform's row from the scan recorded at
+// exactly scannedAt, keyed by platform name. A timestamp with no stored scan
+// yields an empty snapshot.
+func fetchScanSnapshot(username, scannedAt string) (map[string]HistoryEntry, error) {
+	rows, err := db.Query(
+		`SELECT platform, scanned_at, available, error, bio, location, image_hash FROM scan_history WHERE username = ? AND scanned_at = ?`,
+		username, scannedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	snapshot := make(map[string]HistoryEntry)
+	for rows.Next() {
+		var e HistoryEntry
+		var available sql.NullBool
+		if err := rows.Scan(&e.Platform, &e.ScannedAt, &available, &e.Error, &e.Bio, &e.Location, &e.ImageHash); err != nil {
+			return nil, err
+		}
+		if available.Valid {
+			v := available.Bool
+			e.Available = &v
+		}
+		snapshot[e.Platform] = e
+	}
+	return snapshot, rows.Err()
+}
+
+// listScanTimestamps returns every scanned_at value for username, most
+// recent first.
+func listScanTimestamps(username string) ([]string, error) {
+	rows, err := db.Query(`SELECT DISTINCT scanned_at FROM scan_history WHERE username = ? ORDER BY scanned_at DESC`, username)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var timestamps []string
+	for rows.Next() {
+		var ts string
+		if err := rows.Scan(&ts); err != nil {
+			return nil, err
+		}
+		timestamps = append(timestamps, ts)
+	}
+	return timestamps, rows.Err()
+}
+
+// DiffEntry describes one platform whose status or recon data changed
+// between two scans.
+type DiffEntry struct {
+	Platform string `json:"platform"`
+	Change   string `json:"change"`
+	Before   string `json:"before,omitempty"`
+	After    string `json:"after,omitempty"`
+}
+
+func statusLabel(e HistoryEntry) string {
+	switch {
+	case e.Available == nil:
+		return "ERROR"
+	case *e.Available:
+		return "AVAILABLE"
+	default:
+		return "TAKEN"
+	}
+}
+
+// diffSnapshots compares two scans of the same username and reports
+// platforms that flipped AVAILABLE<->TAKEN or whose recon bio/location/image
+// hash changed.
+func diffSnapshots(before, after map[string]HistoryEntry) []DiffEntry {
+	var diffs []DiffEntry
+	platformNames := make(map[string]bool)
+	for name := range before {
+		platformNames[name] = true
+	}
+	for name := range after {
+		platformNames[name] = true
+	}
+	names := make([]string, 0, len(platformNames))
+	for name := range platformNames {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		prev, hadPrev := before[name]
+		curr, hasCurr := after[name]
+		if !hadPrev || !hasCurr {
+			continue
+		}
+		if statusLabel(prev) != statusLabel(curr) {
+			diffs = append(diffs, DiffEntry{Platform: name, Change: "status", Before: statusLabel(prev), After: statusLabel(curr)})
+			continue
+		}
+		if prev.Bio != curr.Bio {
+			diffs = append(diffs, DiffEntry{Platform: name, Change: "bio", Before: prev.Bio, After: curr.Bio})
+		}
+		if prev.Location != curr.Location {
+			diffs = append(diffs, DiffEntry{Platform: name, Change: "location", Before: prev.Location, After: curr.Location})
+		}
+		if prev.ImageHash != curr.ImageHash && prev.ImageHash != "" && curr.ImageHash != "" {
+			diffs = append(diffs, DiffEntry{Platform: name, Change: "image_hash", Before: prev.ImageHash, After: curr.ImageHash})
+		}
+	}
+	return diffs
+}
+
+// --- /monitor: rescan registered usernames on an interval and alert on diff ---
+
+func registerMonitor(username string, intervalSeconds int) error {
+	_, err := db.Exec(
+		`INSERT INTO monitors (username, interval_seconds, last_checked_at) VALUES (?, ?, NULL)
+		 ON CONFLICT(username) DO UPDATE SET interval_seconds = excluded.interval_seconds`,
+		username, intervalSeconds,
+	)
+	return err
+}
+
+type monitorEntry struct {
+	Username        string
+	IntervalSeconds int
+	LastCheckedAt   sql.NullString
+}
+
+func dueMonitors() ([]monitorEntry, error) {
+	rows, err := db.Query(`SELECT username, interval_seconds, last_checked_at FROM monitors`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var due []monitorEntry
+	now := time.Now().UTC()
+	for rows.Next() {
+		var m monitorEntry
+		if err := rows.Scan(&m.Username, &m.IntervalSeconds, &m.LastCheckedAt); err != nil {
+			return nil, err
+		}
+		if !m.LastCheckedAt.Valid {
+			due = append(due, m)
+			continue
+		}
+		last, err := time.Parse(time.RFC3339, m.LastCheckedAt.String)
+		if err != nil || now.Sub(last) >= time.Duration(m.IntervalSeconds)*time.Second {
+			due = append(due, m)
+		}
+	}
+	return due, rows.Err()
+}
+
+// runMonitorLoop rescans due usernames once per tick, persists the results,
+// diffs against the previous scan, and fires a Telegram notification when
+// something changed. Intended to run for the lifetime of the process.
+func runMonitorLoop(tick time.Duration) {
+	for range time.Tick(tick) {
+		due, err := dueMonitors()
+		if err != nil {
+			if logger != nil {
+				logger.Printf("monitor: failed to list due usernames: %v", err)
+			}
+			continue
+		}
+		for _, m := range due {
+			checkMonitoredUsername(m.Username)
+		}
+	}
+}
+
+func markMonitorChecked(username string, checkedAt time.Time) error {
+	_, err := db.Exec(
+		`UPDATE monitors SET last_checked_at = ? WHERE username = ?`,
+		checkedAt.Format(time.RFC3339), username,
+	)
+	return err
+}
+
+func checkMonitoredUsername(username string) {
+	previousTimestamps, err := listScanTimestamps(username)
+	if err != nil && logger != nil {
+		logger.Printf("monitor: failed to list previous scans for %s: %v", username, err)
+	}
+	var previous map[string]HistoryEntry
+	if len(previousTimestamps) > 0 {
+		previous, _ = fetchScanSnapshot(username, previousTimestamps[0])
+	}
+
+	results := scanUsernamesWithPool(username, "", false, 10, true, platforms, nil, nil)
+	scannedAt := time.Now().UTC()
+	persistScanResults(username, results, scannedAt)
+
+	// The monitor row already exists (we're only ever called for registered
+	// usernames), so just stamp when it was last checked; this must not
+	// touch interval_seconds, which belongs to whoever called /monitor.
+	if err := markMonitorChecked(username, scannedAt); err != nil && logger != nil {
+		logger.Printf("monitor: failed to update last_checked_at for %s: %v", username, err)
+	}
+
+	if previous == nil {
+		return
+	}
+	current, err := fetchScanSnapshot(username, scannedAt.UTC().Format(time.RFC3339))
+	if err != nil {
+		return
+	}
+	diffs := diffSnapshots(previous, current)
+	if len(diffs) == 0 {
+		return
+	}
+
+	botToken := os.Getenv("TG_BOT_TOKEN")
+	chatID, ok := telegramVerifiedChat(username)
+	if botToken == "" || !ok {
+		return
+	}
+	var b strings.Builder
+	fmt.Fprintf(&b, "Monitor alert for %s:\n", username)
+	for _, d := range diffs {
+		fmt.Fprintf(&b, "- %s %s: %s -> %s\n", d.Platform, d.Change, d.Before, d.After)
+	}
+	// Plain text: Before/After come from recon'd bio/location, which can
+	// contain arbitrary characters that would otherwise need MarkdownV2 escaping.
+	sendTelegramChunks(botToken, chatID, b.String(), "")
+}
+
 var scanStatus = struct {
 	sync.RWMutex
 	Status map[string]string
 }{Status: make(map[string]string)}
 
-func scanUsernamesWithPool(username string, proxy string, tor bool, threads int, deepScan bool, platforms map[string]Platform) []ScanResult {
+// --- Per-platform progress tracking, so a UI can render a real progress bar ---
+type ScanProgress struct {
+	Queued  int `json:"queued"`
+	Running int `json:"in_flight"`
+	Done    int `json:"done"`
+	Failed  int `json:"failed"`
+}
+
+var scanProgress = struct {
+	sync.RWMutex
+	ByScanID map[string]*ScanProgress
+}{ByScanID: make(map[string]*ScanProgress)}
+
+func newScanProgress(scanid string, total int) *ScanProgress {
+	p := &ScanProgress{Queued: total}
+	scanProgress.Lock()
+	scanProgress.ByScanID[scanid] = p
+	scanProgress.Unlock()
+	return p
+}
+
+func getScanProgress(scanid string) (ScanProgress, bool) {
+	scanProgress.RLock()
+	defer scanProgress.RUnlock()
+	p, ok := scanProgress.ByScanID[scanid]
+	if !ok {
+		return ScanProgress{}, false
+	}
+	return *p, true
+}
+
+// scanUsernamesWithPool runs the scan across a worker pool of `threads` goroutines.
+// If stream is non-nil, each ScanResult is pushed to it as soon as scanPlatform
+// returns, so callers (SSE/WebSocket handlers) can forward results incrementally
+// instead of waiting for the whole scan to finish. The full slice is still
+// returned at the end for callers that only want the final result set.
+func scanUsernamesWithPool(username string, proxy string, tor bool, threads int, deepScan bool, platforms map[string]Platform, stream chan<- ScanResult, progress *ScanProgress) []ScanResult {
 	client := getClient(proxy, tor)
 	type job struct{ name string }
 	type result struct{ scan ScanResult }
@@ -567,7 +1324,32 @@ func scanUsernamesWithPool(username string, proxy string, tor bool, threads int,
 	for w := 0; w < threads; w++ {
 		go func() {
 			for j := range jobs {
+				if progress != nil {
+					scanProgress.Lock()
+					progress.Queued--
+					progress.Running++
+					scanProgress.Unlock()
+				}
 				r := scanPlatform(username, j.name, platforms[j.name], client, deepScan)
+				if progress != nil {
+					scanProgress.Lock()
+					progress.Running--
+					if r.Available == nil {
+						progress.Failed++
+					} else {
+						progress.Done++
+					}
+					scanProgress.Unlock()
+				}
+				// Send to stream before resultsChan: scanUsernamesWithPool
+				// returns as soon as it has drained len(keys) items from
+				// resultsChan, and streamScan closes stream right after
+				// this call returns. Sending to resultsChan first would
+				// let that close race a worker still blocked sending to
+				// stream.
+				if stream != nil {
+					stream <- r
+				}
 				resultsChan <- result{r}
 			}
 		}()
@@ -588,9 +1370,49 @@ func scanUsernamesWithPool(username string, proxy string, tor bool, threads int,
 	return results
 }
 
+// streamScan kicks off a pooled scan in the background and returns a channel
+// that receives each ScanResult as soon as it's ready. The channel is closed
+// once the scan finishes, so callers can simply range over it.
+func streamScan(username, proxyAddr string, tor bool, threads int, deepScan bool, platformsToUse map[string]Platform, scanid string) <-chan ScanResult {
+	stream := make(chan ScanResult, len(platformsToUse))
+	progress := newScanProgress(scanid, len(platformsToUse))
+
+	scanStatus.Lock()
+	scanStatus.Status[scanid] = "Scanning"
+	scanStatus.Unlock()
+
+	go func() {
+		defer close(stream)
+		scanUsernamesWithPool(username, proxyAddr, tor, threads, deepScan, platformsToUse, stream, progress)
+		scanStatus.Lock()
+		scanStatus.Status[scanid] = "Completed"
+		scanStatus.Unlock()
+	}()
+
+	return stream
+}
+
 // --- Main Fiber endpoints ---
 func main() {
 	setupLogger()
+
+	// Load a WhatsMyName-style platform list at startup if one is configured,
+	// so contributors can add hundreds of sites without editing Go code.
+	if platformsFile := os.Getenv("PLATFORMS_FILE"); platformsFile != "" {
+		platforms = loadPlatforms(platformsFile)
+	}
+
+	dbPath := os.Getenv("USRLINKS_DB_PATH")
+	if dbPath == "" {
+		dbPath = "usrlinks.db"
+	}
+	if database, err := initDB(dbPath); err == nil {
+		db = database
+		go runMonitorLoop(time.Minute)
+	} else if logger != nil {
+		logger.Printf("failed to open scan history database %s: %v", dbPath, err)
+	}
+
 	app := fiber.New()
 
 	// Enable CORS for frontend requests
@@ -605,7 +1427,11 @@ func main() {
 		scanStatus.RLock()
 		status := scanStatus.Status[scanid]
 		scanStatus.RUnlock()
-		return c.JSON(fiber.Map{"scanid": scanid, "status": status})
+		resp := fiber.Map{"scanid": scanid, "status": status}
+		if progress, ok := getScanProgress(scanid); ok {
+			resp["progress"] = progress
+		}
+		return c.JSON(resp)
 	})
 
 	app.Get("/check/:username", func(c *fiber.Ctx) error {
@@ -619,6 +1445,7 @@ func main() {
 		deepScan, _ := strconv.ParseBool(c.Query("deep_scan"))
 		output := c.Query("output")
 		generateDorks, _ := strconv.ParseBool(c.Query("generate_dorks"))
+		correlate, _ := strconv.ParseBool(c.Query("correlate"))
 		platformsConfig := c.Query("platforms")
 		scanid := fmt.Sprintf("%s_%d", username, time.Now().UnixNano())
 
@@ -635,7 +1462,7 @@ func main() {
 
 		var results []ScanResult
 		if threads > 1 {
-			results = scanUsernamesWithPool(username, proxy, tor, threads, deepScan, platformsToUse)
+			results = scanUsernamesWithPool(username, proxy, tor, threads, deepScan, platformsToUse, nil, nil)
 		} else {
 			results = scanUsernames(username, proxy, tor, threads, deepScan)
 		}
@@ -647,6 +1474,9 @@ func main() {
 		scanStatus.Status[scanid] = "Completed"
 		scanStatus.Unlock()
 
+		// --- Persist to scan history so /history and /diff have data ---
+		persistScanResults(username, results, time.Now())
+
 		// --- Logging to file ---
 		if logger != nil {
 			logger.Printf("Scan for %s completed. %d results.", username, len(results))
@@ -664,7 +1494,7 @@ func main() {
 		if output == "csv" {
 			b := &bytes.Buffer{}
 			w := csv.NewWriter(b)
-			w.Write([]string{"Platform", "Status", "URL", "Emails", "Phones", "URLs", "Location", "Bio"})
+			w.Write([]string{"Platform", "Status", "URL", "Emails", "Phones", "URLs", "Location", "Bio", "ImageHash"})
 			for _, r := range results {
 				status := "ERROR"
 				if r.Available != nil && *r.Available {
@@ -678,12 +1508,19 @@ func main() {
 				urls := strings.Join(r.ReconData.ContactInfo.URLs, "; ")
 				location := r.ReconData.ContactInfo.Location
 				bio := r.ReconData.ContactInfo.Bio
-				w.Write([]string{r.Platform, status, r.URL, emails, phones, urls, location, bio})
+				w.Write([]string{r.Platform, status, r.URL, emails, phones, urls, location, bio, r.ReconData.ProfileImage.Hash})
 			}
 			w.Flush()
 			c.Set("Content-Type", "text/csv")
 			return c.Send(b.Bytes())
 		}
+
+		// --- Cross-platform profile-image correlation ---
+		if correlate {
+			clusters := correlateProfileImages(results)
+			return c.JSON(fiber.Map{"results": results, "image_clusters": clusters})
+		}
+
 		// Defensive: catch JSON marshal errors
 		resp, err := json.Marshal(results)
 		if err != nil {
@@ -696,6 +1533,83 @@ func main() {
 		return c.Send(resp)
 	})
 
+	// --- Streaming scan results over SSE, one event per ScanResult ---
+	app.Get("/stream/:username", func(c *fiber.Ctx) error {
+		username := c.Params("username")
+		proxy := c.Query("proxy")
+		tor, _ := strconv.ParseBool(c.Query("tor"))
+		threads, _ := strconv.Atoi(c.Query("threads"))
+		if threads <= 0 {
+			threads = 10
+		}
+		deepScan, _ := strconv.ParseBool(c.Query("deep_scan"))
+		platformsConfig := c.Query("platforms")
+		platformsToUse := loadPlatforms(platformsConfig)
+		scanid := fmt.Sprintf("%s_%d", username, time.Now().UnixNano())
+
+		c.Set("Content-Type", "text/event-stream")
+		c.Set("Cache-Control", "no-cache")
+		c.Set("Connection", "keep-alive")
+
+		c.Context().SetBodyStreamWriter(func(w *bufio.Writer) {
+			results := streamScan(username, proxy, tor, threads, deepScan, platformsToUse, scanid)
+			count := 0
+			for res := range results {
+				count++
+				payload, err := json.Marshal(res)
+				if err != nil {
+					continue
+				}
+				fmt.Fprintf(w, "event: scanPlatform\ndata: %s\n\n", payload)
+				if err := w.Flush(); err != nil {
+					return
+				}
+			}
+			summary, _ := json.Marshal(fiber.Map{"scanid": scanid, "total": count})
+			fmt.Fprintf(w, "event: summary\ndata: %s\n\n", summary)
+			w.Flush()
+		})
+		return nil
+	})
+
+	// --- Streaming scan results over a WebSocket, same events as /stream ---
+	app.Use("/ws/:username", func(c *fiber.Ctx) error {
+		if websocket.IsWebSocketUpgrade(c) {
+			c.Locals("username", c.Params("username"))
+			c.Locals("proxy", c.Query("proxy"))
+			c.Locals("tor", c.Query("tor"))
+			c.Locals("threads", c.Query("threads"))
+			c.Locals("deep_scan", c.Query("deep_scan"))
+			c.Locals("platforms", c.Query("platforms"))
+			return c.Next()
+		}
+		return fiber.ErrUpgradeRequired
+	})
+	app.Get("/ws/:username", websocket.New(func(conn *websocket.Conn) {
+		defer conn.Close()
+		username, _ := conn.Locals("username").(string)
+		proxy, _ := conn.Locals("proxy").(string)
+		tor, _ := strconv.ParseBool(fmt.Sprintf("%v", conn.Locals("tor")))
+		threads, _ := strconv.Atoi(fmt.Sprintf("%v", conn.Locals("threads")))
+		if threads <= 0 {
+			threads = 10
+		}
+		deepScan, _ := strconv.ParseBool(fmt.Sprintf("%v", conn.Locals("deep_scan")))
+		platformsConfig, _ := conn.Locals("platforms").(string)
+		platformsToUse := loadPlatforms(platformsConfig)
+		scanid := fmt.Sprintf("%s_%d", username, time.Now().UnixNano())
+
+		results := streamScan(username, proxy, tor, threads, deepScan, platformsToUse, scanid)
+		count := 0
+		for res := range results {
+			count++
+			if err := conn.WriteJSON(fiber.Map{"event": "scanPlatform", "data": res}); err != nil {
+				return
+			}
+		}
+		conn.WriteJSON(fiber.Map{"event": "summary", "data": fiber.Map{"scanid": scanid, "total": count}})
+	}))
+
 	app.Get("/dorks/:username", func(c *fiber.Ctx) error {
 		username := c.Params("username")
 		dorks := generateGoogleDorks(username)
@@ -703,7 +1617,113 @@ func main() {
 	})
 
 	app.Get("/platforms", func(c *fiber.Ctx) error {
-		return c.JSON(platforms)
+		return c.JSON(fiber.Map{
+			"platforms": platforms,
+			"rate_limits": fiber.Map{
+				"default_rps":        defaultRateRPS,
+				"default_burst":      defaultRateBurst,
+				"global_concurrency": cap(globalRequestSemaphore),
+			},
+		})
+	})
+
+	// --- Correlate profile pictures across platforms via perceptual hashing ---
+	app.Get("/correlate/:username", func(c *fiber.Ctx) error {
+		username := c.Params("username")
+		proxy := c.Query("proxy")
+		tor, _ := strconv.ParseBool(c.Query("tor"))
+		threads, _ := strconv.Atoi(c.Query("threads"))
+		if threads <= 0 {
+			threads = 10
+		}
+		results := scanUsernamesWithPool(username, proxy, tor, threads, true, platforms, nil, nil)
+		clusters := correlateProfileImages(results)
+		return c.JSON(fiber.Map{"username": username, "results": results, "image_clusters": clusters})
+	})
+
+	// --- Scan history, diffing, and monitoring (requires the SQLite store) ---
+	app.Get("/history/:username", func(c *fiber.Ctx) error {
+		if db == nil {
+			return c.Status(500).JSON(fiber.Map{"error": "scan history database unavailable"})
+		}
+		username := c.Params("username")
+		timestamps, err := listScanTimestamps(username)
+		if err != nil {
+			return c.Status(500).JSON(fiber.Map{"error": err.Error()})
+		}
+		scans := make([]fiber.Map, 0, len(timestamps))
+		for _, ts := range timestamps {
+			snapshot, err := fetchScanSnapshot(username, ts)
+			if err != nil {
+				continue
+			}
+			platformsAtTs := make([]HistoryEntry, 0, len(snapshot))
+			for _, e := range snapshot {
+				platformsAtTs = append(platformsAtTs, e)
+			}
+			sort.Slice(platformsAtTs, func(i, j int) bool { return platformsAtTs[i].Platform < platformsAtTs[j].Platform })
+			scans = append(scans, fiber.Map{"scanned_at": ts, "platforms": platformsAtTs})
+		}
+		return c.JSON(fiber.Map{"username": username, "scans": scans})
+	})
+
+	app.Get("/diff/:username", func(c *fiber.Ctx) error {
+		if db == nil {
+			return c.Status(500).JSON(fiber.Map{"error": "scan history database unavailable"})
+		}
+		username := c.Params("username")
+		since := c.Query("since")
+		timestamps, err := listScanTimestamps(username)
+		if err != nil {
+			return c.Status(500).JSON(fiber.Map{"error": err.Error()})
+		}
+		if len(timestamps) < 2 {
+			return c.JSON(fiber.Map{"username": username, "diff": []DiffEntry{}})
+		}
+		latest := timestamps[0]
+		baseline := timestamps[1]
+		if since != "" {
+			for _, ts := range timestamps {
+				if ts <= since {
+					baseline = ts
+					break
+				}
+			}
+		}
+		before, err := fetchScanSnapshot(username, baseline)
+		if err != nil {
+			return c.Status(500).JSON(fiber.Map{"error": err.Error()})
+		}
+		after, err := fetchScanSnapshot(username, latest)
+		if err != nil {
+			return c.Status(500).JSON(fiber.Map{"error": err.Error()})
+		}
+		return c.JSON(fiber.Map{
+			"username": username,
+			"since":    baseline,
+			"until":    latest,
+			"diff":     diffSnapshots(before, after),
+		})
+	})
+
+	app.Post("/monitor", func(c *fiber.Ctx) error {
+		if db == nil {
+			return c.Status(500).JSON(fiber.Map{"error": "scan history database unavailable"})
+		}
+		var req struct {
+			Username        string `json:"username"`
+			IntervalSeconds int    `json:"interval_seconds"`
+		}
+		if err := c.BodyParser(&req); err != nil || req.Username == "" {
+			return c.Status(400).JSON(fiber.Map{"error": "username required"})
+		}
+		if req.IntervalSeconds <= 0 {
+			req.IntervalSeconds = 3600
+		}
+		if err := registerMonitor(req.Username, req.IntervalSeconds); err != nil {
+			return c.Status(500).JSON(fiber.Map{"error": err.Error()})
+		}
+		return c.JSON(fiber.Map{"status": "ok", "username": req.Username, "interval_seconds": req.IntervalSeconds})
 	})
 
 	// --- Feedback endpoint ---
@@ -725,6 +1745,44 @@ func main() {
 		return c.JSON(fiber.Map{"status": "ok"})
 	})
 
+	// --- Telegram PIN verification: prove chat ownership before the bot DMs you ---
+	app.Post("/telegram/pin", func(c *fiber.Ctx) error {
+		var req struct {
+			Label string `json:"label"`
+		}
+		if err := c.BodyParser(&req); err != nil || req.Label == "" {
+			return c.Status(400).JSON(fiber.Map{"error": "label required"})
+		}
+		pin := generateTelegramPIN(req.Label)
+		return c.JSON(fiber.Map{"pin": pin, "instructions": fmt.Sprintf("Send \"/verify %s\" to the bot", pin)})
+	})
+
+	app.Get("/telegram/verified/:label", func(c *fiber.Ctx) error {
+		label := c.Params("label")
+		chatID, ok := telegramVerifiedChat(label)
+		return c.JSON(fiber.Map{"label": label, "verified": ok, "chat_id": chatID})
+	})
+
+	// --- Telegram webhook: alternative to getUpdates polling ---
+	app.Post("/telegram/webhook", func(c *fiber.Ctx) error {
+		botToken := os.Getenv("TG_BOT_TOKEN")
+		if botToken == "" {
+			return c.Status(500).JSON(fiber.Map{"error": "telegram bot token not set"})
+		}
+		var update tgUpdate
+		if err := c.BodyParser(&update); err != nil {
+			return c.Status(400).JSON(fiber.Map{"error": "invalid update"})
+		}
+		go handleTelegramUpdate(botToken, update)
+		return c.JSON(fiber.Map{"status": "ok"})
+	})
+
+	// Run the bot in getUpdates polling mode unless TG_BOT_MODE=webhook, in
+	// which case Telegram is expected to be pointed at /telegram/webhook.
+	if botToken := os.Getenv("TG_BOT_TOKEN"); botToken != "" && os.Getenv("TG_BOT_MODE") != "webhook" {
+		go startTelegramBotPolling(botToken)
+	}
+
 	fmt.Println("USRLINKS Fiber backend running on :8080")
 	app.Listen("0.0.0.0:8080")
 }
@@ -736,12 +1794,47 @@ func sendTelegramFeedback(name, message string) error {
 	if botToken == "" || chatID == "" {
 		return fmt.Errorf("telegram bot token or chat ID not set")
 	}
-	text := fmt.Sprintf("*Feedback from %s:*\n%s", name, message)
+	text := fmt.Sprintf("*Feedback from %s:*\n%s", escapeMarkdownV2(name), escapeMarkdownV2(message))
+	return sendTelegramMessage(botToken, chatID, text, "MarkdownV2")
+}
+
+// markdownV2Special lists every character MarkdownV2 requires to be escaped
+// outside of an entity (https://core.telegram.org/bots/api#markdownv2-style).
+const markdownV2Special = "_*[]()~`>#+-=|{}.!\\"
+
+// escapeMarkdownV2 escapes plain interpolated text so it renders literally
+// instead of being parsed as MarkdownV2 entities.
+func escapeMarkdownV2(s string) string {
+	var b strings.Builder
+	for _, r := range s {
+		if strings.ContainsRune(markdownV2Special, r) {
+			b.WriteByte('\\')
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
+
+// escapeMarkdownV2URL escapes the subset of characters MarkdownV2 requires
+// inside the (url) part of an inline link: ")" and "\".
+func escapeMarkdownV2URL(s string) string {
+	s = strings.ReplaceAll(s, `\`, `\\`)
+	return strings.ReplaceAll(s, ")", "\\)")
+}
+
+// sendTelegramMessage posts a single message to a chat via the Bot API.
+// parseMode is "MarkdownV2" for formatted text (callers must escape any
+// interpolated text with escapeMarkdownV2 before wrapping it in entities) or
+// "" to send as plain text, which needs no escaping at all.
+func sendTelegramMessage(botToken, chatID, text, parseMode string) error {
 	url := fmt.Sprintf("https://api.telegram.org/bot%s/sendMessage", botToken)
 	payload := map[string]interface{}{
-		"chat_id":    chatID,
-		"text":       text,
-		"parse_mode": "Markdown",
+		"chat_id":                  chatID,
+		"text":                     text,
+		"disable_web_page_preview": true,
+	}
+	if parseMode != "" {
+		payload["parse_mode"] = parseMode
 	}
 	body, _ := json.Marshal(payload)
 	resp, err := http.Post(url, "application/json", bytes.NewReader(body))
@@ -763,11 +1856,265 @@ func sendTelegramFeedback(name, message string) error {
 	return nil
 }
 
+// --- Telegram bot subsystem: /scan, /dorks, /platforms, /deep from chat ---
+
+type tgChat struct {
+	ID int64 `json:"id"`
+}
+
+type tgMessage struct {
+	MessageID int64  `json:"message_id"`
+	Chat      tgChat `json:"chat"`
+	Text      string `json:"text"`
+}
+
+type tgUpdate struct {
+	UpdateID int64     `json:"update_id"`
+	Message  tgMessage `json:"message"`
+}
+
+type tgGetUpdatesResponse struct {
+	OK     bool       `json:"ok"`
+	Result []tgUpdate `json:"result"`
+}
+
+const tgMaxMessageLen = 3500 // stay well under Telegram's 4096-char limit
+
+// telegramAllowedChats is the whitelist of chat IDs loaded from
+// TG_ALLOWED_CHAT_IDS (comma-separated). An empty whitelist allows any chat,
+// matching the permissive default of the rest of the API.
+var telegramAllowedChats = loadTelegramAllowlist()
+
+func loadTelegramAllowlist() map[int64]bool {
+	allowed := make(map[int64]bool)
+	raw := os.Getenv("TG_ALLOWED_CHAT_IDS")
+	if raw == "" {
+		return allowed
+	}
+	for _, part := range strings.Split(raw, ",") {
+		part = strings.TrimSpace(part)
+		if id, err := strconv.ParseInt(part, 10, 64); err == nil {
+			allowed[id] = true
+		}
+	}
+	return allowed
+}
+
+func telegramChatAllowed(chatID int64) bool {
+	if len(telegramAllowedChats) == 0 {
+		return true
+	}
+	return telegramAllowedChats[chatID]
+}
+
+// telegramPins binds a PIN requested over the HTTP API to the chat that
+// later proves ownership by sending it to the bot, mirroring the pattern of
+// proving Telegram ownership before the bot will DM someone.
+var telegramPins = struct {
+	sync.Mutex
+	ByPIN map[string]string // pin -> label
+}{ByPIN: make(map[string]string)}
+
+var telegramVerifiedChats = struct {
+	sync.RWMutex
+	ByLabel map[string]int64 // label -> bound chat ID
+}{ByLabel: make(map[string]int64)}
+
+func generateTelegramPIN(label string) string {
+	pin := fmt.Sprintf("%06d", rand.Intn(1000000))
+	telegramPins.Lock()
+	telegramPins.ByPIN[pin] = label
+	telegramPins.Unlock()
+	return pin
+}
+
+func verifyTelegramPIN(pin string, chatID int64) (string, bool) {
+	telegramPins.Lock()
+	label, ok := telegramPins.ByPIN[pin]
+	if ok {
+		delete(telegramPins.ByPIN, pin)
+	}
+	telegramPins.Unlock()
+	if !ok {
+		return "", false
+	}
+	telegramVerifiedChats.Lock()
+	telegramVerifiedChats.ByLabel[label] = chatID
+	telegramVerifiedChats.Unlock()
+	return label, true
+}
+
+func telegramVerifiedChat(label string) (int64, bool) {
+	telegramVerifiedChats.RLock()
+	defer telegramVerifiedChats.RUnlock()
+	chatID, ok := telegramVerifiedChats.ByLabel[label]
+	return chatID, ok
+}
+
+// formatResultsMarkdown renders scan results as a MarkdownV2 list, one line
+// per platform in the order given, marked available, taken (with a clickable
+// link) or error. Results are not grouped or sorted by status.
+func formatResultsMarkdown(username string, results []ScanResult) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "*Scan results for %s*\n\n", escapeMarkdownV2(username))
+	for _, r := range results {
+		platform := escapeMarkdownV2(r.Platform)
+		switch {
+		case r.Available == nil:
+			fmt.Fprintf(&b, "⚠️ %s \\- error: %s\n", platform, escapeMarkdownV2(r.Error))
+		case *r.Available:
+			fmt.Fprintf(&b, "⬜ %s \\- available\n", platform)
+		default:
+			fmt.Fprintf(&b, "✅ [%s](%s) \\- taken\n", platform, escapeMarkdownV2URL(r.URL))
+			if r.ReconData.ContactInfo.Bio != "" {
+				fmt.Fprintf(&b, "   _%s_\n", escapeMarkdownV2(r.ReconData.ContactInfo.Bio))
+			}
+		}
+	}
+	return b.String()
+}
+
+// chunkMessage splits long bot output across multiple Telegram messages so
+// each chunk stays under the API's per-message length limit.
+func chunkMessage(text string) []string {
+	if len(text) <= tgMaxMessageLen {
+		return []string{text}
+	}
+	var chunks []string
+	lines := strings.Split(text, "\n")
+	var current strings.Builder
+	for _, line := range lines {
+		if current.Len()+len(line)+1 > tgMaxMessageLen {
+			chunks = append(chunks, current.String())
+			current.Reset()
+		}
+		current.WriteString(line)
+		current.WriteString("\n")
+	}
+	if current.Len() > 0 {
+		chunks = append(chunks, current.String())
+	}
+	return chunks
+}
+
+func sendTelegramChunks(botToken string, chatID int64, text string, parseMode string) {
+	for _, chunk := range chunkMessage(text) {
+		if err := sendTelegramMessage(botToken, strconv.FormatInt(chatID, 10), chunk, parseMode); err != nil {
+			if logger != nil {
+				logger.Printf("Telegram bot: failed to send chunk to %d: %v", chatID, err)
+			}
+		}
+	}
+}
+
+// handleTelegramUpdate dispatches a single incoming Update to the matching
+// bot command. It's shared by the getUpdates poller and the webhook handler.
+func handleTelegramUpdate(botToken string, update tgUpdate) {
+	chatID := update.Message.Chat.ID
+	text := strings.TrimSpace(update.Message.Text)
+	if text == "" {
+		return
+	}
+	fields := strings.Fields(text)
+	cmd := fields[0]
+	arg := ""
+	if len(fields) > 1 {
+		arg = fields[1]
+	}
+
+	// /verify doesn't require the chat to already be allow-listed, since it's
+	// how a chat proves ownership in the first place.
+	if cmd == "/verify" {
+		if arg == "" {
+			sendTelegramChunks(botToken, chatID, "Usage: /verify <pin>", "")
+			return
+		}
+		if label, ok := verifyTelegramPIN(arg, chatID); ok {
+			sendTelegramChunks(botToken, chatID, fmt.Sprintf("Verified! This chat is now bound to %q.", label), "")
+		} else {
+			sendTelegramChunks(botToken, chatID, "Invalid or expired PIN.", "")
+		}
+		return
+	}
+
+	if !telegramChatAllowed(chatID) {
+		if logger != nil {
+			logger.Printf("Telegram bot: rejected update from unallowed chat %d", chatID)
+		}
+		return
+	}
+
+	switch cmd {
+	case "/scan":
+		if arg == "" {
+			sendTelegramChunks(botToken, chatID, "Usage: /scan <username>", "")
+			return
+		}
+		results := scanUsernamesWithPool(arg, "", false, 10, false, platforms, nil, nil)
+		sendTelegramChunks(botToken, chatID, formatResultsMarkdown(arg, results), "MarkdownV2")
+	case "/deep":
+		if arg == "" {
+			sendTelegramChunks(botToken, chatID, "Usage: /deep <username>", "")
+			return
+		}
+		results := scanUsernamesWithPool(arg, "", false, 10, true, platforms, nil, nil)
+		sendTelegramChunks(botToken, chatID, formatResultsMarkdown(arg, results), "MarkdownV2")
+	case "/dorks":
+		if arg == "" {
+			sendTelegramChunks(botToken, chatID, "Usage: /dorks <username>", "")
+			return
+		}
+		dorks := generateGoogleDorks(arg)
+		sendTelegramChunks(botToken, chatID, fmt.Sprintf("Google dorks for %s:\n%s", arg, strings.Join(dorks, "\n")), "")
+	case "/platforms":
+		names := make([]string, 0, len(platforms))
+		for name := range platforms {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		sendTelegramChunks(botToken, chatID, fmt.Sprintf("Supported platforms (%d):\n%s", len(names), strings.Join(names, ", ")), "")
+	default:
+		sendTelegramChunks(botToken, chatID, "Unknown command. Try /scan, /deep, /dorks or /platforms.", "")
+	}
+}
+
+// startTelegramBotPolling long-polls getUpdates in the background for as
+// long as the process runs. Used when TG_BOT_MODE is unset or "poll".
+func startTelegramBotPolling(botToken string) {
+	var offset int64
+	client := &http.Client{Timeout: 35 * time.Second}
+	for {
+		url := fmt.Sprintf("https://api.telegram.org/bot%s/getUpdates?timeout=30&offset=%d", botToken, offset)
+		resp, err := client.Get(url)
+		if err != nil {
+			if logger != nil {
+				logger.Printf("Telegram bot: getUpdates error: %v", err)
+			}
+			time.Sleep(5 * time.Second)
+			continue
+		}
+		var parsed tgGetUpdatesResponse
+		err = json.NewDecoder(resp.Body).Decode(&parsed)
+		resp.Body.Close()
+		if err != nil || !parsed.OK {
+			time.Sleep(5 * time.Second)
+			continue
+		}
+		for _, update := range parsed.Result {
+			offset = update.UpdateID + 1
+			go handleTelegramUpdate(botToken, update)
+		}
+	}
+}
+
 // 1. Terminal UI & Styling
 // Not relevant for web backend. Fiber returns JSON/CSV, not colored terminal output.
 
 // 2. Progress Bar / Status Updates
-// Not implemented. Fiber is stateless HTTP; for live progress, use WebSocket/SSE.
+// Implemented via /stream/:username (SSE) and /ws/:username (WebSocket), both
+// emitting a scanPlatform event per ScanResult plus a final summary event.
+// For scans started over /stream or /ws, /status/:scanid also reports
+// queued/in_flight/done/failed platform counts; /check scans report no counts.
 
 // 3. Retry failed platforms after scan
 // Only per-request retries are implemented. No post-scan retry for failed platforms.